@@ -0,0 +1,58 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMRouteTable_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_route_table.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccDataSourceAzureRMRouteTable_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.name", "route1"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.address_prefix", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.next_hop_type", "VnetLocal"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMRouteTable_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  route {
+    name           = "route1"
+    address_prefix = "10.1.0.0/16"
+    next_hop_type  = "VnetLocal"
+  }
+}
+
+data "azurerm_route_table" "test" {
+  name                = "${azurerm_route_table.test.name}"
+  resource_group_name = "${azurerm_route_table.test.resource_group_name}"
+}
+`, rInt, location, rInt)
+}
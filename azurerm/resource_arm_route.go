@@ -0,0 +1,228 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRouteCreateUpdate,
+		Read:   resourceArmRouteRead,
+		Update: resourceArmRouteCreateUpdate,
+		Delete: resourceArmRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 30),
+			Update: schema.DefaultTimeout(time.Minute * 30),
+			Delete: schema.DefaultTimeout(time.Minute * 30),
+		},
+
+		CustomizeDiff: resourceArmRouteCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"route_table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"next_hop_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.RouteNextHopTypeVirtualNetworkGateway),
+					string(network.RouteNextHopTypeVnetLocal),
+					string(network.RouteNextHopTypeInternet),
+					string(network.RouteNextHopTypeVirtualAppliance),
+					string(network.RouteNextHopTypeNone),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"next_hop_in_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Route creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	rtName := d.Get("route_table_name").(string)
+
+	if d.IsNewResource() {
+		// first check if there's one in this subscription requiring import
+		resp, err := client.Get(ctx, resGroup, rtName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error checking for the existence of Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
+			}
+		}
+
+		if resp.ID != nil {
+			return tf.ImportAsExistsError("azurerm_route", *resp.ID)
+		}
+	}
+
+	addressPrefix := d.Get("address_prefix").(string)
+	nextHopType := d.Get("next_hop_type").(string)
+
+	properties := network.RoutePropertiesFormat{
+		AddressPrefix: &addressPrefix,
+		NextHopType:   network.RouteNextHopType(nextHopType),
+	}
+
+	if v, ok := d.GetOk("next_hop_in_ip_address"); ok {
+		properties.NextHopIPAddress = utils.String(v.(string))
+	}
+
+	route := network.Route{
+		Name:                  &name,
+		RoutePropertiesFormat: &properties,
+	}
+
+	armMutexKV.Lock(rtName)
+	defer armMutexKV.Unlock(rtName)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, rtName, name, route)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(tf.TimeoutForCreateUpdate(d)))
+	defer cancel()
+	if err := future.WaitForCompletionRef(waitCtx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, rtName, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Route %q (Route Table %q / Resource Group %q) ID", name, rtName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRouteRead(d, meta)
+}
+
+func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	rtName := id.Path["routeTables"]
+	name := id.Path["routes"]
+
+	resp, err := client.Get(ctx, resGroup, rtName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Route %q: %+v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("route_table_name", rtName)
+
+	if props := resp.RoutePropertiesFormat; props != nil {
+		d.Set("address_prefix", props.AddressPrefix)
+		d.Set("next_hop_type", string(props.NextHopType))
+		if ip := props.NextHopIPAddress; ip != nil {
+			d.Set("next_hop_in_ip_address", ip)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	rtName := id.Path["routeTables"]
+	name := id.Path["routes"]
+
+	armMutexKV.Lock(rtName)
+	defer armMutexKV.Unlock(rtName)
+
+	future, err := client.Delete(ctx, resGroup, rtName, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
+		}
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if err := future.WaitForCompletionRef(waitCtx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
+	}
+
+	return nil
+}
+
+func resourceArmRouteCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	nextHopType := d.Get("next_hop_type").(string)
+	nextHopIPAddress := d.Get("next_hop_in_ip_address").(string)
+	nextHopIPAddressKnown := d.NewValueKnown("next_hop_in_ip_address")
+
+	if err := validateRouteNextHop(nextHopType, nextHopIPAddress, nextHopIPAddressKnown); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("address_prefix") {
+		return nil
+	}
+
+	return validateRouteAddressPrefix(d.Get("address_prefix").(string))
+}
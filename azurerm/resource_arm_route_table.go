@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
@@ -31,6 +33,8 @@ func resourceArmRouteTable() *schema.Resource {
 			Delete: schema.DefaultTimeout(time.Minute * 30),
 		},
 
+		CustomizeDiff: resourceArmRouteTableCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -143,6 +147,9 @@ func resourceArmRouteTableCreateUpdate(d *schema.ResourceData, meta interface{})
 		Tags: expandTags(tags),
 	}
 
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, routeSet)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Route Table %q (Resource Group %q): %+v", name, resGroup, err)
@@ -221,6 +228,9 @@ func resourceArmRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 	resGroup := id.ResourceGroup
 	name := id.Path["routeTables"]
 
+	armMutexKV.Lock(name)
+	defer armMutexKV.Unlock(name)
+
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
@@ -238,6 +248,83 @@ func resourceArmRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceArmRouteTableCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	routes := d.Get("route").([]interface{})
+
+	prefixes := make(map[string][]int)
+	var hopErrors []string
+
+	for i, routeRaw := range routes {
+		route := routeRaw.(map[string]interface{})
+
+		nextHopType := route["next_hop_type"].(string)
+		nextHopIPAddress := route["next_hop_in_ip_address"].(string)
+		nextHopIPAddressKnown := d.NewValueKnown(fmt.Sprintf("route.%d.next_hop_in_ip_address", i))
+
+		if err := validateRouteNextHop(nextHopType, nextHopIPAddress, nextHopIPAddressKnown); err != nil {
+			hopErrors = append(hopErrors, fmt.Sprintf("%s (route %d)", err, i))
+		}
+
+		addressPrefix := route["address_prefix"].(string)
+		addressPrefixKnown := d.NewValueKnown(fmt.Sprintf("route.%d.address_prefix", i))
+		if !addressPrefixKnown {
+			continue
+		}
+
+		if err := validateRouteAddressPrefix(addressPrefix); err != nil {
+			hopErrors = append(hopErrors, fmt.Sprintf("%s (route %d)", err, i))
+			continue
+		}
+
+		prefixes[addressPrefix] = append(prefixes[addressPrefix], i)
+	}
+
+	if len(hopErrors) > 0 {
+		return fmt.Errorf("Error validating `route`:\n%s", strings.Join(hopErrors, "\n"))
+	}
+
+	var duplicateErrors []string
+	for prefix, indices := range prefixes {
+		if len(indices) > 1 {
+			duplicateErrors = append(duplicateErrors, fmt.Sprintf("`address_prefix` %q is used by more than one route (routes %v)", prefix, indices))
+		}
+	}
+
+	if len(duplicateErrors) > 0 {
+		return fmt.Errorf("Error validating `route`:\n%s", strings.Join(duplicateErrors, "\n"))
+	}
+
+	return nil
+}
+
+// validateRouteNextHop checks that `next_hop_in_ip_address` is set if and
+// only if `next_hop_type` is `VirtualAppliance`. An unknown (not-yet-computed)
+// next_hop_in_ip_address is left unvalidated rather than treated as unset.
+func validateRouteNextHop(nextHopType, nextHopIPAddress string, nextHopIPAddressKnown bool) error {
+	if !nextHopIPAddressKnown {
+		return nil
+	}
+
+	isVirtualAppliance := strings.EqualFold(nextHopType, string(network.RouteNextHopTypeVirtualAppliance))
+	if isVirtualAppliance && nextHopIPAddress == "" {
+		return fmt.Errorf("`next_hop_in_ip_address` must be set when `next_hop_type` is `VirtualAppliance`")
+	}
+	if !isVirtualAppliance && nextHopIPAddress != "" {
+		return fmt.Errorf("`next_hop_in_ip_address` can only be set when `next_hop_type` is `VirtualAppliance`")
+	}
+
+	return nil
+}
+
+// validateRouteAddressPrefix checks that `address_prefix` parses as a CIDR.
+func validateRouteAddressPrefix(addressPrefix string) error {
+	if _, _, err := net.ParseCIDR(addressPrefix); err != nil {
+		return fmt.Errorf("`address_prefix` %q is not a valid CIDR: %+v", addressPrefix, err)
+	}
+
+	return nil
+}
+
 func expandRouteTableRoutes(d *schema.ResourceData) ([]network.Route, error) {
 	configs := d.Get("route").([]interface{})
 	routes := make([]network.Route, 0, len(configs))
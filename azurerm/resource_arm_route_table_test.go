@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRouteTable_customizeDiffMissingNextHopIPAddress(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMRouteTable_routeWithNextHopType(ri, location, "VirtualAppliance", ""),
+				ExpectError: regexp.MustCompile("next_hop_in_ip_address.*must be set"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRouteTable_customizeDiffUnexpectedNextHopIPAddress(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMRouteTable_routeWithNextHopType(ri, location, "Internet", "10.0.0.1"),
+				ExpectError: regexp.MustCompile("next_hop_in_ip_address.*can only be set"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRouteTable_customizeDiffInvalidCIDR(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMRouteTable_routeWithAddressPrefix(ri, location, "not-a-cidr"),
+				ExpectError: regexp.MustCompile("is not a valid CIDR"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRouteTable_customizeDiffDuplicateAddressPrefix(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMRouteTable_duplicateAddressPrefix(ri, location),
+				ExpectError: regexp.MustCompile("is used by more than one route"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRouteTableDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).routeTablesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_route_table" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Route Table %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMRouteTable_routeWithNextHopType(rInt int, location, nextHopType, nextHopIPAddress string) string {
+	nextHopLine := ""
+	if nextHopIPAddress != "" {
+		nextHopLine = fmt.Sprintf(`next_hop_in_ip_address = "%s"`, nextHopIPAddress)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  route {
+    name           = "route1"
+    address_prefix = "10.1.0.0/16"
+    next_hop_type  = "%s"
+    %s
+  }
+}
+`, rInt, location, rInt, nextHopType, nextHopLine)
+}
+
+func testAccAzureRMRouteTable_routeWithAddressPrefix(rInt int, location, addressPrefix string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  route {
+    name           = "route1"
+    address_prefix = "%s"
+    next_hop_type  = "VnetLocal"
+  }
+}
+`, rInt, location, rInt, addressPrefix)
+}
+
+func testAccAzureRMRouteTable_duplicateAddressPrefix(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  route {
+    name           = "route1"
+    address_prefix = "10.1.0.0/16"
+    next_hop_type  = "VnetLocal"
+  }
+
+  route {
+    name           = "route2"
+    address_prefix = "10.1.0.0/16"
+    next_hop_type  = "VnetLocal"
+  }
+}
+`, rInt, location, rInt)
+}
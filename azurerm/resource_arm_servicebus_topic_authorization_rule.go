@@ -8,6 +8,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -51,6 +52,24 @@ func resourceArmServiceBusTopicAuthorizationRule() *schema.Resource {
 			},
 
 			"resource_group_name": resourceGroupNameSchema(),
+
+			"key_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			"regenerate_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Primary",
+				ValidateFunc: validation.StringInSlice([]string{"Primary", "Secondary"}, false),
+			},
+
+			"last_rotated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		}),
 
 		CustomizeDiff: azure.ServiceBusAuthorizationRuleCustomizeDiff,
@@ -93,6 +112,20 @@ func resourceArmServiceBusTopicAuthorizationRuleCreateUpdate(d *schema.ResourceD
 		return fmt.Errorf("Error creating/updating ServiceBus Topic Authorization Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if !d.IsNewResource() && d.HasChange("key_version") {
+		keyType := servicebus.PrimaryKey
+		if d.Get("regenerate_key_type").(string) == "Secondary" {
+			keyType = servicebus.SecondaryKey
+		}
+
+		log.Printf("[INFO] Regenerating %s key for ServiceBus Topic Authorization Rule %q", keyType, name)
+		if _, err := client.RegenerateKeys(ctx, resourceGroup, namespaceName, topicName, name, servicebus.RegenerateAccessKeyParameters{KeyType: keyType}); err != nil {
+			return fmt.Errorf("Error regenerating %s key for ServiceBus Topic Authorization Rule %q (Resource Group %q): %+v", keyType, name, resourceGroup, err)
+		}
+
+		d.Set("last_rotated", time.Now().UTC().Format(time.RFC3339))
+	}
+
 	resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, topicName, name)
 	if err != nil {
 		return err
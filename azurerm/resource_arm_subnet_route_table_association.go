@@ -0,0 +1,216 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubnetRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetRouteTableAssociationCreateUpdate,
+		Read:   resourceArmSubnetRouteTableAssociationRead,
+		Delete: resourceArmSubnetRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmSubnetRouteTableAssociationImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 30),
+			Delete: schema.DefaultTimeout(time.Minute * 30),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetRouteTableAssociationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	subnetsClient := meta.(*ArmClient).subnetsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Subnet <-> Route Table Association creation.")
+
+	subnetId := d.Get("subnet_id").(string)
+	routeTableId := d.Get("route_table_id").(string)
+
+	parsedSubnetId, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	resGroup := parsedSubnetId.ResourceGroup
+	vnetName := parsedSubnetId.Path["virtualNetworks"]
+	subnetName := parsedSubnetId.Path["subnets"]
+
+	armMutexKV.Lock(vnetName)
+	defer armMutexKV.Unlock(vnetName)
+	armMutexKV.Lock(subnetName)
+	defer armMutexKV.Unlock(subnetName)
+
+	subnet, err := subnetsClient.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(subnet.Response) {
+			return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) was not found", subnetName, vnetName, resGroup)
+		}
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if props := subnet.SubnetPropertiesFormat; props != nil {
+		props.RouteTable = &network.RouteTable{
+			ID: utils.String(routeTableId),
+		}
+	}
+
+	future, err := subnetsClient.CreateOrUpdate(ctx, resGroup, vnetName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error updating Route Table Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(tf.TimeoutForCreateUpdate(d)))
+	defer cancel()
+	if err := future.WaitForCompletionRef(waitCtx, subnetsClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Route Table Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	read, err := subnetsClient.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subnet %q (Virtual Network %q / Resource Group %q) ID", subnetName, vnetName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubnetRouteTableAssociationRead(d, meta)
+}
+
+func resourceArmSubnetRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	subnetsClient := meta.(*ArmClient).subnetsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	resp, err := subnetsClient.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) was not found - removing from state", subnetName, vnetName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	props := resp.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, vnetName, resGroup)
+	}
+
+	if props.RouteTable == nil || props.RouteTable.ID == nil {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) has no Route Table - removing from state", subnetName, vnetName, resGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", resp.ID)
+	d.Set("route_table_id", props.RouteTable.ID)
+
+	return nil
+}
+
+func resourceArmSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	subnetsClient := meta.(*ArmClient).subnetsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	armMutexKV.Lock(vnetName)
+	defer armMutexKV.Unlock(vnetName)
+	armMutexKV.Lock(subnetName)
+	defer armMutexKV.Unlock(subnetName)
+
+	subnet, err := subnetsClient.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(subnet.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	if props := subnet.SubnetPropertiesFormat; props != nil {
+		props.RouteTable = nil
+	}
+
+	future, err := subnetsClient.CreateOrUpdate(ctx, resGroup, vnetName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error removing Route Table Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if err := future.WaitForCompletionRef(waitCtx, subnetsClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Route Table Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	return nil
+}
+
+func resourceArmSubnetRouteTableAssociationImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	subnetsClient := meta.(*ArmClient).subnetsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	resGroup := id.ResourceGroup
+	vnetName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+
+	resp, err := subnetsClient.Get(ctx, resGroup, vnetName, subnetName, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, vnetName, resGroup, err)
+	}
+
+	props := resp.SubnetPropertiesFormat
+	if props == nil || props.RouteTable == nil || props.RouteTable.ID == nil {
+		return nil, fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) has no Route Table attached - cannot import `azurerm_subnet_route_table_association`", subnetName, vnetName, resGroup)
+	}
+
+	d.Set("subnet_id", resp.ID)
+	d.Set("route_table_id", props.RouteTable.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,133 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubnetRouteTableAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_route_table_association.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMSubnetRouteTableAssociation_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetRouteTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetRouteTableAssociationExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetRouteTableAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.Attributes["subnet_id"])
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		client := testAccProvider.Meta().(*ArmClient).subnetsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, subnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group %q) does not exist", subnetName, vnetName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on subnetsClient: %+v", err)
+		}
+
+		props := resp.SubnetPropertiesFormat
+		if props == nil || props.RouteTable == nil || props.RouteTable.ID == nil {
+			return fmt.Errorf("Bad: no Route Table found attached to Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, vnetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubnetRouteTableAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).subnetsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subnet_route_table_association" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.Attributes["subnet_id"])
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vnetName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		resp, err := client.Get(ctx, resourceGroup, vnetName, subnetName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		if props := resp.SubnetPropertiesFormat; props != nil && props.RouteTable != nil {
+			return fmt.Errorf("Route Table is still attached to Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, vnetName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubnetRouteTableAssociation_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet_route_table_association" "test" {
+  subnet_id      = "${azurerm_subnet.test.id}"
+  route_table_id = "${azurerm_route_table.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}